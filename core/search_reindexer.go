@@ -0,0 +1,32 @@
+package core
+
+import (
+	"context"
+
+	"github.com/navidrome/navidrome/log"
+	"github.com/navidrome/navidrome/model"
+)
+
+// SearchReindexer rebuilds the full-text search index from scratch when Run is called. The
+// search_fts triggers keep the index in sync with day-to-day writes, so this job exists
+// mainly as a safety net against drift (e.g. rows written while triggers were disabled
+// during a bulk import) and to pick up tokenizer/ranking changes after an upgrade.
+type SearchReindexer struct {
+	engine model.SearchEngine
+}
+
+func NewSearchReindexer(engine model.SearchEngine) *SearchReindexer {
+	return &SearchReindexer{engine: engine}
+}
+
+// Run rebuilds the search index. SearchReindexer does not schedule itself: the rebuild
+// happens only when a caller invokes Run.
+func (j *SearchReindexer) Run(ctx context.Context) error {
+	log.Info(ctx, "Running full-text search reindex job")
+	if err := j.engine.IndexAll(ctx); err != nil {
+		log.Error(ctx, "Full-text search reindex failed", err)
+		return err
+	}
+	log.Info(ctx, "Full-text search reindex finished")
+	return nil
+}
@@ -0,0 +1,19 @@
+package model
+
+import "context"
+
+// DataStore is the aggregate repository accessor threaded through core and server/subsonic.
+// persistence.SQLStore is the only production implementation.
+type DataStore interface {
+	Album(ctx context.Context) AlbumRepository
+	Artist(ctx context.Context) ArtistRepository
+	MediaFile(ctx context.Context) MediaFileRepository
+
+	// SearchEngine returns the full-text SearchEngine backing searchType=fts and fuzziness>0 queries.
+	SearchEngine() SearchEngine
+
+	// SuggestionProvider returns the autocomplete backend for getSearchSuggestions.view.
+	SuggestionProvider() SuggestionProvider
+
+	SavedSearch(ctx context.Context) SavedSearchRepository
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	. "github.com/Masterminds/squirrel"
+)
+
+// SavedSearchRules is a rule-based search definition, evaluated against the library as SQL
+// rather than free text, effectively giving server-side smart playlists surfaced through
+// Subsonic search. Zero-valued fields are left out of the generated filter.
+type SavedSearchRules struct {
+	Genre        string     `json:"genre,omitempty"`
+	YearFrom     int        `json:"yearFrom,omitempty"`
+	YearTo       int        `json:"yearTo,omitempty"`
+	MinRating    int        `json:"minRating,omitempty"`
+	MinPlayCount int        `json:"minPlayCount,omitempty"`
+	AddedAfter   *time.Time `json:"addedAfter,omitempty"`
+}
+
+// SavedSearch is a query (free-text, using the extended search syntax, and/or rule-based)
+// persisted by a user so it can be pinned and re-executed via runSavedSearch.
+type SavedSearch struct {
+	ID        string
+	UserID    string
+	Name      string
+	Query     string
+	Rules     SavedSearchRules
+	Pinned    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ToFilters translates the rule definition into the same kind of squirrel filter
+// expression used for musicFolderIds filtering in searchAll, so rule-based saved searches
+// can be run as a predicate against MediaFile/Album instead of a free-text match. rating and
+// play_count aren't columns on MediaFile/Album: they're per-user annotations, so those two
+// rules are expressed as an "id IN (...)" subquery against annotation scoped to userID,
+// mirroring the LEFT JOIN annotation used by the search suggestions provider.
+func (r SavedSearchRules) ToFilters(userID string) Sqlizer {
+	var filters And
+	if r.Genre != "" {
+		filters = append(filters, Eq{"genre": r.Genre})
+	}
+	if r.YearFrom != 0 {
+		filters = append(filters, GtOrEq{"year": r.YearFrom})
+	}
+	if r.YearTo != 0 {
+		filters = append(filters, LtOrEq{"year": r.YearTo})
+	}
+	if r.MinRating != 0 {
+		filters = append(filters, Expr("id IN (SELECT item_id FROM annotation WHERE user_id = ? AND rating >= ?)", userID, r.MinRating))
+	}
+	if r.MinPlayCount != 0 {
+		filters = append(filters, Expr("id IN (SELECT item_id FROM annotation WHERE user_id = ? AND play_count >= ?)", userID, r.MinPlayCount))
+	}
+	if r.AddedAfter != nil {
+		filters = append(filters, GtOrEq{"created_at": *r.AddedAfter})
+	}
+	return filters
+}
+
+type SavedSearches []SavedSearch
+
+type SavedSearchRepository interface {
+	Put(*SavedSearch) error
+	Get(id string) (*SavedSearch, error)
+	GetAll(options ...QueryOptions) (SavedSearches, error)
+	Delete(id string) error
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/Masterminds/squirrel"
+)
+
+func TestSavedSearchRules_ToFilters(t *testing.T) {
+	t.Run("empty rules produce no filters", func(t *testing.T) {
+		got := SavedSearchRules{}.ToFilters("user-1")
+		if sql, _, _ := got.ToSql(); sql != "" {
+			t.Errorf("ToSql() = %q, want empty", sql)
+		}
+	})
+
+	t.Run("every rule contributes its own predicate", func(t *testing.T) {
+		addedAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		rules := SavedSearchRules{
+			Genre:        "Rock",
+			YearFrom:     1975,
+			YearTo:       1980,
+			MinRating:    4,
+			MinPlayCount: 10,
+			AddedAfter:   &addedAfter,
+		}
+		sql, args, err := rules.ToFilters("user-1").ToSql()
+		if err != nil {
+			t.Fatalf("ToSql() error: %v", err)
+		}
+		wantArgs := []any{"Rock", 1975, 1980, "user-1", 4, "user-1", 10, addedAfter}
+		if len(args) != len(wantArgs) {
+			t.Fatalf("args = %v, want %v", args, wantArgs)
+		}
+		for i := range args {
+			if args[i] != wantArgs[i] {
+				t.Errorf("args[%d] = %v, want %v", i, args[i], wantArgs[i])
+			}
+		}
+		if sql == "" {
+			t.Error("expected a non-empty SQL predicate")
+		}
+	})
+
+	t.Run("rating and play count filter via the annotation table, scoped to the user", func(t *testing.T) {
+		rules := SavedSearchRules{MinRating: 4, MinPlayCount: 10}
+		sql, args, err := rules.ToFilters("user-1").ToSql()
+		if err != nil {
+			t.Fatalf("ToSql() error: %v", err)
+		}
+		if !strings.Contains(sql, "SELECT item_id FROM annotation WHERE user_id = ? AND rating >= ?") {
+			t.Errorf("ToSql() = %q, want a rating subquery against annotation", sql)
+		}
+		if !strings.Contains(sql, "SELECT item_id FROM annotation WHERE user_id = ? AND play_count >= ?") {
+			t.Errorf("ToSql() = %q, want a play_count subquery against annotation", sql)
+		}
+		wantArgs := []any{"user-1", 4, "user-1", 10}
+		if len(args) != len(wantArgs) {
+			t.Fatalf("args = %v, want %v", args, wantArgs)
+		}
+	})
+
+	t.Run("zero-valued fields are left out", func(t *testing.T) {
+		rules := SavedSearchRules{Genre: "Jazz"}
+		_, args, err := rules.ToFilters("user-1").ToSql()
+		if err != nil {
+			t.Fatalf("ToSql() error: %v", err)
+		}
+		if len(args) != 1 || args[0] != "Jazz" {
+			t.Errorf("args = %v, want just the genre", args)
+		}
+	})
+}
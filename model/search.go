@@ -0,0 +1,57 @@
+package model
+
+import "context"
+
+// SearchEntity identifies which indexed entity a SearchEngine query targets.
+type SearchEntity string
+
+const (
+	SearchEntityMediaFile SearchEntity = "media_file"
+	SearchEntityAlbum     SearchEntity = "album"
+	SearchEntityArtist    SearchEntity = "artist"
+)
+
+// SearchHit is a single ranked match returned by a SearchEngine, ordered best-first.
+type SearchHit struct {
+	ID    string
+	Score float64
+}
+
+// SearchSuggestion is a single autocomplete completion returned by a SuggestionProvider,
+// keyed by the entity it resolves to so as-you-type UIs can link straight to it.
+type SearchSuggestion struct {
+	Term     string
+	Type     SearchEntity
+	ID       string
+	HitCount int
+}
+
+// SuggestionProvider returns ranked autocomplete completions for a partial query, backed by
+// a prefix index over normalized entity names. Implementations should weight items the
+// given user has played more recently/frequently higher, breaking ties by text relevance.
+type SuggestionProvider interface {
+	Suggest(ctx context.Context, userID, prefix string, entity SearchEntity, count int) ([]SearchSuggestion, error)
+}
+
+// SearchEngine indexes text for media files, albums and artists and resolves free-form
+// queries against that index, supporting quoted phrases, boolean operators (AND/OR/NOT)
+// and field-scoped terms (artist:, album:, genre:, year:1975..1980). Implementations are
+// expected to rank hits by relevance (e.g. BM25) rather than database insertion order.
+type SearchEngine interface {
+	// Search returns up to size hits for entity, offset by offset, ordered by descending
+	// relevance. fuzziness is the trigram-overlap threshold (see FuzzinessOff/Default/
+	// Permissive): 0 keeps exact/prefix matching only, 1 and 2 backfill short exact-match
+	// result sets with typo-tolerant trigram matches on the first page (offset 0).
+	Search(ctx context.Context, entity SearchEntity, q string, fuzziness, offset, size int) ([]SearchHit, error)
+
+	// IndexAll rebuilds the index from scratch. Used by the background reindex job.
+	IndexAll(ctx context.Context) error
+
+	// IndexEntity (re)indexes a single entity row. Nothing in this package calls it; the
+	// index is updated only where a caller invokes it or IndexAll.
+	IndexEntity(ctx context.Context, entity SearchEntity, id string) error
+
+	// RemoveEntity drops a single entity row from the index. Like IndexEntity, it runs only
+	// where a caller invokes it.
+	RemoveEntity(ctx context.Context, entity SearchEntity, id string) error
+}
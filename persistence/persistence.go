@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/navidrome/navidrome/model"
+)
+
+// SQLStore is the production model.DataStore implementation: a thin registry of
+// repositories sharing one *sql.DB connection pool.
+type SQLStore struct {
+	db                 *sql.DB
+	searchEngine       model.SearchEngine
+	suggestionProvider model.SuggestionProvider
+}
+
+// New creates a model.DataStore backed by db.
+func New(db *sql.DB) model.DataStore {
+	return &SQLStore{
+		db:                 db,
+		searchEngine:       NewFTSSearchEngine(db),
+		suggestionProvider: NewFTSSuggestionProvider(db),
+	}
+}
+
+func (s *SQLStore) Album(ctx context.Context) model.AlbumRepository {
+	return NewAlbumRepository(ctx, s.db)
+}
+
+func (s *SQLStore) Artist(ctx context.Context) model.ArtistRepository {
+	return NewArtistRepository(ctx, s.db)
+}
+
+func (s *SQLStore) MediaFile(ctx context.Context) model.MediaFileRepository {
+	return NewMediaFileRepository(ctx, s.db)
+}
+
+func (s *SQLStore) SearchEngine() model.SearchEngine {
+	return s.searchEngine
+}
+
+func (s *SQLStore) SuggestionProvider() model.SuggestionProvider {
+	return s.suggestionProvider
+}
+
+func (s *SQLStore) SavedSearch(ctx context.Context) model.SavedSearchRepository {
+	return NewSavedSearchRepository(ctx, s.db)
+}
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/navidrome/navidrome/model"
+)
+
+// sqlSavedSearchRepository stores SavedSearch rows directly against *sql.DB, following the
+// same lightweight style as ftsSearchEngine/ftsSuggestionProvider rather than going through
+// the squirrel-based sqlRepository base used by the older repositories.
+type sqlSavedSearchRepository struct {
+	ctx    context.Context
+	userID string
+	db     *sql.DB
+}
+
+func NewSavedSearchRepository(ctx context.Context, db *sql.DB) model.SavedSearchRepository {
+	userID := userID(ctx)
+	return &sqlSavedSearchRepository{ctx: ctx, userID: userID, db: db}
+}
+
+func (r *sqlSavedSearchRepository) Put(s *model.SavedSearch) error {
+	rules, err := json.Marshal(s.Rules)
+	if err != nil {
+		return fmt.Errorf("marshaling saved search rules: %w", err)
+	}
+	now := time.Now()
+	if s.ID == "" {
+		s.ID = uuid.NewString()
+		s.UserID = r.userID
+		s.CreatedAt = now
+	}
+	s.UpdatedAt = now
+
+	// WHERE scopes the update to rows owned by r.userID, same as Get/GetAll/Delete: without
+	// it, a conflicting id from another user's saved search would be silently overwritten.
+	_, err = r.db.ExecContext(r.ctx, `
+		INSERT INTO saved_search (id, user_id, name, query, rules, pinned, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			name = excluded.name, query = excluded.query, rules = excluded.rules,
+			pinned = excluded.pinned, updated_at = excluded.updated_at
+		WHERE saved_search.user_id = ?
+	`, s.ID, s.UserID, s.Name, s.Query, string(rules), s.Pinned, s.CreatedAt, s.UpdatedAt, r.userID)
+	return err
+}
+
+func (r *sqlSavedSearchRepository) Get(id string) (*model.SavedSearch, error) {
+	row := r.db.QueryRowContext(r.ctx, `
+		SELECT id, user_id, name, query, rules, pinned, created_at, updated_at
+		FROM saved_search WHERE id = ? AND user_id = ?
+	`, id, r.userID)
+	s, err := scanSavedSearch(row)
+	if err != nil {
+		return nil, fmt.Errorf("getting saved search %q: %w", id, err)
+	}
+	return s, nil
+}
+
+func (r *sqlSavedSearchRepository) GetAll(options ...model.QueryOptions) (model.SavedSearches, error) {
+	rows, err := r.db.QueryContext(r.ctx, `
+		SELECT id, user_id, name, query, rules, pinned, created_at, updated_at
+		FROM saved_search WHERE user_id = ? ORDER BY pinned DESC, name
+	`, r.userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting saved searches: %w", err)
+	}
+	defer rows.Close()
+
+	var all model.SavedSearches
+	for rows.Next() {
+		s, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, *s)
+	}
+	return all, rows.Err()
+}
+
+func (r *sqlSavedSearchRepository) Delete(id string) error {
+	_, err := r.db.ExecContext(r.ctx, `DELETE FROM saved_search WHERE id = ? AND user_id = ?`, id, r.userID)
+	return err
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows, letting Get and GetAll share one
+// scan routine.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSavedSearch(row rowScanner) (*model.SavedSearch, error) {
+	var s model.SavedSearch
+	var rules string
+	if err := row.Scan(&s.ID, &s.UserID, &s.Name, &s.Query, &rules, &s.Pinned, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if rules != "" {
+		if err := json.Unmarshal([]byte(rules), &s.Rules); err != nil {
+			return nil, fmt.Errorf("unmarshaling saved search rules: %w", err)
+		}
+	}
+	return &s, nil
+}
@@ -0,0 +1,252 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/navidrome/navidrome/log"
+	"github.com/navidrome/navidrome/model"
+)
+
+// ftsSearchEngine is a model.SearchEngine backed by the search_fts SQLite FTS5 virtual
+// table (see migration 20240610000000_add_search_fts.sql). It is kept in sync with
+// media_file, album and artist via the triggers created in that same migration, plus
+// IndexEntity/RemoveEntity for the paths the triggers can't cover (e.g. a bulk scanner
+// import that writes with triggers temporarily disabled).
+type ftsSearchEngine struct {
+	db *sql.DB
+}
+
+// NewFTSSearchEngine creates a model.SearchEngine that queries the search_fts FTS5 table.
+func NewFTSSearchEngine(db *sql.DB) model.SearchEngine {
+	return &ftsSearchEngine{db: db}
+}
+
+func (e *ftsSearchEngine) Search(ctx context.Context, entity model.SearchEntity, q string, fuzziness, offset, size int) ([]model.SearchHit, error) {
+	hits, err := e.ftsMatch(ctx, entity, q, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	// Only backfill the first page: merging trigram matches into later pages would require
+	// ranking exact and fuzzy hits against each other, which bm25 scores and Jaccard overlap
+	// scores aren't comparable enough to do well.
+	if fuzziness == FuzzinessOff || offset > 0 || len(hits) >= size {
+		return hits, nil
+	}
+
+	seen := make(map[string]bool, len(hits))
+	for _, h := range hits {
+		seen[h.ID] = true
+	}
+	fuzzy, err := searchTrigrams(ctx, e.db, entity, q, fuzziness)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range fuzzy {
+		if seen[m.EntityID] {
+			continue
+		}
+		hits = append(hits, model.SearchHit{ID: m.EntityID, Score: m.Score})
+		seen[m.EntityID] = true
+		if len(hits) >= size {
+			break
+		}
+	}
+	return hits, nil
+}
+
+// ftsMatch runs the exact/prefix FTS5 query: boolean operators, quoted phrases, field
+// prefixes and year ranges, ranked by bm25. Falls back to ordering by rowid for a
+// year-range-only query, since there's no MATCH expression left to rank by in that case.
+func (e *ftsSearchEngine) ftsMatch(ctx context.Context, entity model.SearchEntity, q string, offset, size int) ([]model.SearchHit, error) {
+	parsed, err := parseSearchFTSQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	// year is UNINDEXED in search_fts (see migration 20240610000000_add_search_fts.sql), so a
+	// year:1975..1980 term can't be expressed inside the MATCH expression itself; apply it as
+	// an ordinary SQL predicate against the stored year text instead.
+	yearFilter := ""
+	yearArgs := []any{}
+	if parsed.hasYearRange {
+		yearFilter = "AND CAST(year AS INTEGER) BETWEEN ? AND ?"
+		yearArgs = []any{parsed.yearFrom, parsed.yearTo}
+	}
+
+	// A query of only a year range (no text tokens) leaves parsed.match empty. search_fts
+	// MATCH '' is an FTS5 syntax error, and bm25() requires an active MATCH to rank by, so
+	// when there's nothing to match against, drop both and rank by rowid instead.
+	var query string
+	args := []any{string(entity)}
+	if parsed.match == "" {
+		query = fmt.Sprintf(`
+			SELECT entity_id, 0 AS rank
+			FROM search_fts
+			WHERE entity_type = ? %s
+			ORDER BY rowid
+			LIMIT ? OFFSET ?
+		`, yearFilter)
+		args = append(args, yearArgs...)
+	} else {
+		query = fmt.Sprintf(`
+			SELECT entity_id, bm25(search_fts) AS rank
+			FROM search_fts
+			WHERE entity_type = ? AND search_fts MATCH ? %s
+			ORDER BY rank
+			LIMIT ? OFFSET ?
+		`, yearFilter)
+		args = append(args, parsed.match)
+		args = append(args, yearArgs...)
+	}
+	args = append(args, size, offset)
+	//nolint:gosec // yearFilter is one of two fixed literals, never built from user input
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching %s for %q: %w", entity, q, err)
+	}
+	defer rows.Close()
+
+	var hits []model.SearchHit
+	for rows.Next() {
+		var hit model.SearchHit
+		// bm25() returns smaller-is-better scores; invert so callers can sort descending by relevance.
+		var rank float64
+		if err := rows.Scan(&hit.ID, &rank); err != nil {
+			return nil, err
+		}
+		hit.Score = -rank
+		hits = append(hits, hit)
+	}
+	return hits, rows.Err()
+}
+
+// IndexAll rebuilds search_fts from scratch. Unlike the 'rebuild' special INSERT (which only
+// reoptimizes FTS5's own b-tree from rows already present in search_fts), this truncates the
+// table and re-runs the same backfill used by the migration, so it actually recovers from
+// drift against media_file/album/artist (e.g. rows written while triggers were disabled
+// during a bulk import).
+func (e *ftsSearchEngine) IndexAll(ctx context.Context) error {
+	log.Debug(ctx, "Rebuilding search_fts index")
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("rebuilding search_fts index: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM search_fts`); err != nil {
+		return fmt.Errorf("rebuilding search_fts index: %w", err)
+	}
+	backfills := []string{
+		`INSERT INTO search_fts(rowid, entity_type, entity_id, artist, album, genre, year, text)
+		 SELECT rowid, 'media_file', id, artist, album, genre, CAST(year AS TEXT), title || ' ' || artist || ' ' || album
+		 FROM media_file`,
+		`INSERT INTO search_fts(rowid, entity_type, entity_id, artist, album, genre, year, text)
+		 SELECT rowid + 10000000, 'album', id, album_artist, name, genre, CAST(max_year AS TEXT), name || ' ' || album_artist
+		 FROM album`,
+		`INSERT INTO search_fts(rowid, entity_type, entity_id, artist, album, genre, year, text)
+		 SELECT rowid + 20000000, 'artist', id, name, '', '', '', name
+		 FROM artist`,
+	}
+	for _, stmt := range backfills {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("rebuilding search_fts index: %w", err)
+		}
+	}
+	if err := reindexTrigramsFromFTS(ctx, tx); err != nil {
+		return fmt.Errorf("rebuilding search_fts index: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("rebuilding search_fts index: %w", err)
+	}
+	return nil
+}
+
+// reindexTrigramsFromFTS rebuilds search_trigram from the rows search_fts was just
+// backfilled with, keeping fuzzy matching in sync with a full reindex the same way
+// IndexEntity keeps it in sync with a single-row write.
+func reindexTrigramsFromFTS(ctx context.Context, tx *sql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM search_trigram`); err != nil {
+		return err
+	}
+	rows, err := tx.QueryContext(ctx, `SELECT entity_type, entity_id, text FROM search_fts`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO search_trigram (entity_type, entity_id, trigram) VALUES (?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for rows.Next() {
+		var entityType, entityID, text string
+		if err := rows.Scan(&entityType, &entityID, &text); err != nil {
+			return err
+		}
+		for _, t := range trigramsOf(text) {
+			if _, err := stmt.ExecContext(ctx, entityType, entityID, t); err != nil {
+				return err
+			}
+		}
+	}
+	return rows.Err()
+}
+
+func (e *ftsSearchEngine) IndexEntity(ctx context.Context, entity model.SearchEntity, id string) error {
+	table, textExpr, ok := searchFTSSource(entity)
+	if !ok {
+		return fmt.Errorf("unsupported search entity %q", entity)
+	}
+	var text string
+	//nolint:gosec // table/textExpr come from the fixed searchFTSSource switch, not user input
+	row := e.db.QueryRowContext(ctx, fmt.Sprintf(`SELECT %s FROM %s WHERE id = ?`, textExpr, table), id)
+	if err := row.Scan(&text); err != nil {
+		return fmt.Errorf("indexing %s %q: %w", entity, id, err)
+	}
+
+	_, err := e.db.ExecContext(ctx, `DELETE FROM search_fts WHERE entity_type = ? AND entity_id = ?`, string(entity), id)
+	if err != nil {
+		return err
+	}
+	// Source columns for artist, album, genre and year, matching the IndexAll backfill.
+	columns := map[model.SearchEntity]string{
+		model.SearchEntityMediaFile: "artist, album, genre, CAST(year AS TEXT)",
+		model.SearchEntityAlbum:     "album_artist, name, genre, CAST(max_year AS TEXT)",
+		model.SearchEntityArtist:    "name, '', '', ''",
+	}[entity]
+	//nolint:gosec // table/textExpr/columns come from fixed per-entity literals, not user input
+	query := fmt.Sprintf(`
+		INSERT INTO search_fts(rowid, entity_type, entity_id, artist, album, genre, year, text)
+		SELECT (SELECT COALESCE(MAX(rowid), 0) + 1 FROM search_fts), ?, id, %s, %s
+		FROM %s WHERE id = ?
+	`, columns, textExpr, table)
+	if _, err := e.db.ExecContext(ctx, query, string(entity), id); err != nil {
+		return err
+	}
+	return indexTrigrams(ctx, e.db, entity, id, text)
+}
+
+func (e *ftsSearchEngine) RemoveEntity(ctx context.Context, entity model.SearchEntity, id string) error {
+	if _, err := e.db.ExecContext(ctx, `DELETE FROM search_fts WHERE entity_type = ? AND entity_id = ?`, string(entity), id); err != nil {
+		return err
+	}
+	return removeTrigrams(ctx, e.db, entity, id)
+}
+
+// searchFTSSource returns the source table and the expression used to populate the
+// full-text "text" column for entity.
+func searchFTSSource(entity model.SearchEntity) (table, textExpr string, ok bool) {
+	switch entity {
+	case model.SearchEntityMediaFile:
+		return "media_file", "title || ' ' || artist || ' ' || album", true
+	case model.SearchEntityAlbum:
+		return "album", "name || ' ' || album_artist", true
+	case model.SearchEntityArtist:
+		return "artist", "name", true
+	default:
+		return "", "", false
+	}
+}
@@ -0,0 +1,157 @@
+package persistence
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fields that can be scoped with a "field:value" prefix in a search query, mapped to the
+// column indexed in the search_fts virtual table. "year" is deliberately absent: it's an
+// UNINDEXED column holding a value to range-filter on, not a token FTS5 can MATCH against,
+// so it's pulled out into parsedSearchQuery.yearFrom/yearTo instead of the MATCH expression.
+var searchFTSFields = map[string]string{
+	"artist": "artist",
+	"album":  "album",
+	"genre":  "genre",
+}
+
+// parsedSearchQuery is what parseSearchFTSQuery hands back to ftsSearchEngine: a FTS5 MATCH
+// expression for the token/phrase/boolean part of the query, plus an optional year range to
+// apply as a plain SQL predicate alongside the MATCH, since year:1975..1980 isn't expressible
+// as an FTS5 MATCH term against an UNINDEXED column.
+type parsedSearchQuery struct {
+	match        string
+	yearFrom     int
+	yearTo       int
+	hasYearRange bool
+}
+
+// parseSearchFTSQuery translates Navidrome's search syntax (quoted phrases, AND/OR/NOT,
+// field prefixes and year ranges) into a SQLite FTS5 MATCH expression plus an optional year
+// range. Bare words are treated as prefix matches (word*), mirroring the old LIKE '%word%'
+// behavior as closely as FTS5 allows. An empty q yields an empty match expression, which
+// ftsMatch treats as matching every row of the entity.
+func parseSearchFTSQuery(q string) (parsedSearchQuery, error) {
+	tokens, err := tokenizeSearchQuery(q)
+	if err != nil {
+		return parsedSearchQuery{}, err
+	}
+	var parsed parsedSearchQuery
+	var parts []string
+	for _, t := range tokens {
+		switch strings.ToUpper(t) {
+		case "AND":
+			parts = append(parts, "AND")
+		case "OR":
+			parts = append(parts, "OR")
+		case "NOT":
+			parts = append(parts, "NOT")
+		default:
+			if from, to, ok := yearRangeTerm(t); ok {
+				parsed.yearFrom, parsed.yearTo, parsed.hasYearRange = from, to, true
+				continue
+			}
+			parts = append(parts, translateSearchTerm(t))
+		}
+	}
+	parsed.match = strings.Join(parts, " ")
+	return parsed, nil
+}
+
+// tokenizeSearchQuery splits q on whitespace, keeping double-quoted phrases intact.
+func tokenizeSearchQuery(q string) ([]string, error) {
+	var tokens []string
+	var cur strings.Builder
+	inQuotes := false
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+	for _, r := range q {
+		switch {
+		case r == '"':
+			cur.WriteRune(r)
+			inQuotes = !inQuotes
+		case r == ' ' && !inQuotes:
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted phrase in query %q", q)
+	}
+	flush()
+	return tokens, nil
+}
+
+// yearRangeTerm reports whether t is a "year:start..end" or "year:YYYY" term, pulling the
+// range out so the caller applies it as a SQL predicate instead of folding it into the FTS5
+// MATCH expression (year is UNINDEXED and doesn't support MATCH comparisons).
+func yearRangeTerm(t string) (from, to int, ok bool) {
+	const prefix = "year:"
+	if len(t) <= len(prefix) || !strings.EqualFold(t[:len(prefix)], prefix) {
+		return 0, 0, false
+	}
+	return parseYearRange(t[len(prefix):])
+}
+
+// translateSearchTerm converts a single search token (possibly a quoted phrase or a
+// field:value prefix) into its FTS5 MATCH equivalent. year: terms are handled upstream by
+// yearRangeTerm and never reach here.
+func translateSearchTerm(t string) string {
+	field := ""
+	value := t
+	if idx := strings.IndexByte(t, ':'); idx > 0 {
+		candidate := strings.ToLower(t[:idx])
+		if col, ok := searchFTSFields[candidate]; ok {
+			field = col
+			value = t[idx+1:]
+		}
+	}
+
+	quoted := strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) && len(value) >= 2
+	if quoted {
+		value = value[1 : len(value)-1]
+	}
+	// Quote the literal so FTS5-significant punctuation in user input (parens, stray quotes,
+	// colons, etc.) is treated as plain text instead of query syntax; an embedded quote is
+	// escaped the same way SQL string literals escape one, by doubling it. A quoted string
+	// followed by * is still a valid FTS5 prefix query, so this doesn't change behavior for
+	// ordinary words, just makes it safe for everything else.
+	term := ftsQuote(value)
+	if !quoted {
+		term += "*"
+	}
+
+	if field != "" {
+		return fmt.Sprintf("%s:%s", field, term)
+	}
+	return term
+}
+
+// ftsQuote wraps value as an FTS5 string literal, doubling any embedded double quotes.
+func ftsQuote(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+}
+
+// parseYearRange parses "1975..1980" into (1975, 1980, true). A single year "1975" is
+// treated as the range (1975, 1975, true).
+func parseYearRange(value string) (from, to int, ok bool) {
+	if idx := strings.Index(value, ".."); idx >= 0 {
+		a, err1 := strconv.Atoi(value[:idx])
+		b, err2 := strconv.Atoi(value[idx+2:])
+		if err1 != nil || err2 != nil {
+			return 0, 0, false
+		}
+		return a, b, true
+	}
+	y, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, 0, false
+	}
+	return y, y, true
+}
@@ -0,0 +1,85 @@
+package persistence
+
+import "testing"
+
+func TestParseSearchFTSQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		q         string
+		wantMatch string
+		wantFrom  int
+		wantTo    int
+		wantRange bool
+	}{
+		{"bare word", "beatles", `"beatles"*`, 0, 0, false},
+		{"quoted phrase", `"dark side"`, `"dark side"`, 0, 0, false},
+		{"boolean operators", "beatles AND abbey NOT road", `"beatles"* AND "abbey"* NOT "road"*`, 0, 0, false},
+		{"field prefix", "artist:beatles", `artist:"beatles"*`, 0, 0, false},
+		{"year range", "year:1975..1980", ``, 1975, 1980, true},
+		{"single year", "year:1975", ``, 1975, 1975, true},
+		{"year range combined with a term", "beatles year:1975..1980", `"beatles"*`, 1975, 1980, true},
+		{"fts5-significant punctuation", `beatles(`, `"beatles("*`, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSearchFTSQuery(tt.q)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.match != tt.wantMatch {
+				t.Errorf("match = %q, want %q", got.match, tt.wantMatch)
+			}
+			if got.hasYearRange != tt.wantRange || got.yearFrom != tt.wantFrom || got.yearTo != tt.wantTo {
+				t.Errorf("year range = (%d, %d, %v), want (%d, %d, %v)",
+					got.yearFrom, got.yearTo, got.hasYearRange, tt.wantFrom, tt.wantTo, tt.wantRange)
+			}
+		})
+	}
+}
+
+func TestParseSearchFTSQuery_UnterminatedQuote(t *testing.T) {
+	if _, err := parseSearchFTSQuery(`"dark side`); err == nil {
+		t.Fatal("expected an error for an unterminated quoted phrase")
+	}
+}
+
+func TestTranslateSearchTerm(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{"beatles", `"beatles"*`},
+		{`"dark side"`, `"dark side"`},
+		{"artist:beatles", `artist:"beatles"*`},
+		{"genre:Rock", `genre:"Rock"*`},
+		{"notafield:value", `"notafield:value"*`},
+		{`beatles(`, `"beatles("*`},
+		{`beatles"rock`, `"beatles""rock"*`},
+	}
+	for _, tt := range tests {
+		if got := translateSearchTerm(tt.term); got != tt.want {
+			t.Errorf("translateSearchTerm(%q) = %q, want %q", tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestParseYearRange(t *testing.T) {
+	tests := []struct {
+		value    string
+		wantFrom int
+		wantTo   int
+		wantOK   bool
+	}{
+		{"1975..1980", 1975, 1980, true},
+		{"1975", 1975, 1975, true},
+		{"not-a-year", 0, 0, false},
+		{"1975..", 0, 0, false},
+	}
+	for _, tt := range tests {
+		from, to, ok := parseYearRange(tt.value)
+		if ok != tt.wantOK || from != tt.wantFrom || to != tt.wantTo {
+			t.Errorf("parseYearRange(%q) = (%d, %d, %v), want (%d, %d, %v)",
+				tt.value, from, to, ok, tt.wantFrom, tt.wantTo, tt.wantOK)
+		}
+	}
+}
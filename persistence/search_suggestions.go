@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/navidrome/navidrome/model"
+)
+
+// ftsSuggestionProvider answers autocomplete completions from the same search_fts table
+// used by ftsSearchEngine, joined against annotation so a user's own play history nudges
+// their frequently/recently played artists, albums and tracks to the top of the list.
+type ftsSuggestionProvider struct {
+	db *sql.DB
+}
+
+func NewFTSSuggestionProvider(db *sql.DB) model.SuggestionProvider {
+	return &ftsSuggestionProvider{db: db}
+}
+
+func (p *ftsSuggestionProvider) Suggest(ctx context.Context, userID, prefix string, entity model.SearchEntity, count int) ([]model.SearchSuggestion, error) {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" || count <= 0 {
+		return nil, nil
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT f.entity_id, f.text, COALESCE(a.play_count, 0) AS play_count
+		FROM search_fts f
+		LEFT JOIN annotation a ON a.item_id = f.entity_id AND a.item_type = f.entity_type AND a.user_id = ?
+		WHERE f.entity_type = ? AND f.search_fts MATCH ?
+		ORDER BY play_count DESC, a.play_date DESC, bm25(search_fts)
+		LIMIT ?
+	`, userID, string(entity), ftsQuote(prefix)+"*", count)
+	if err != nil {
+		return nil, fmt.Errorf("getting search suggestions for %s %q: %w", entity, prefix, err)
+	}
+	defer rows.Close()
+
+	var suggestions []model.SearchSuggestion
+	for rows.Next() {
+		var id, text string
+		var playCount int
+		if err := rows.Scan(&id, &text, &playCount); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, model.SearchSuggestion{
+			Term:     text,
+			Type:     entity,
+			ID:       id,
+			HitCount: playCount,
+		})
+	}
+	return suggestions, rows.Err()
+}
@@ -0,0 +1,147 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/navidrome/navidrome/model"
+)
+
+// Fuzziness thresholds for search_trigram scoring, selected via the fuzziness request
+// parameter (see getSearchParams). 0 disables trigram matching entirely (exact/prefix
+// matches only); 1 is the default trigram overlap threshold; 2 is the permissive setting.
+const (
+	FuzzinessOff        = 0
+	FuzzinessDefault    = 1
+	FuzzinessPermissive = 2
+)
+
+// trigramThreshold maps a fuzziness level to the minimum Jaccard overlap a candidate's
+// trigram set must have with the query's trigram set to be considered a fuzzy match.
+func trigramThreshold(fuzziness int) float64 {
+	switch fuzziness {
+	case FuzzinessPermissive:
+		return 0.15
+	case FuzzinessDefault:
+		return 0.3
+	default:
+		return 1 // effectively disables fuzzy matching, only exact trigram sets match
+	}
+}
+
+// trigramMatch is a fuzzy candidate found via search_trigram, scored by overlap with the
+// query's own trigram set.
+type trigramMatch struct {
+	EntityType string
+	EntityID   string
+	Score      float64
+}
+
+// trigramsOf splits s into its constituent (lowercased) character trigrams, padding short
+// words with boundary markers so 2-letter words still produce at least one trigram.
+func trigramsOf(s string) []string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return nil
+	}
+	padded := "  " + s + " "
+	runes := []rune(padded)
+	var out []string
+	for i := 0; i+3 <= len(runes); i++ {
+		out = append(out, string(runes[i:i+3]))
+	}
+	return out
+}
+
+// searchTrigrams finds entities of entity whose indexed name has a trigram overlap with q
+// at or above the threshold implied by fuzziness, ordered by best match first. It is
+// merged with the existing prefix/FTS matchers by the caller rather than used standalone.
+func searchTrigrams(ctx context.Context, db *sql.DB, entity model.SearchEntity, q string, fuzziness int) ([]trigramMatch, error) {
+	queryTrigrams := trigramsOf(q)
+	if len(queryTrigrams) == 0 || fuzziness == FuzzinessOff {
+		return nil, nil
+	}
+	threshold := trigramThreshold(fuzziness)
+
+	placeholders := make([]string, len(queryTrigrams))
+	trigramArgs := make([]any, len(queryTrigrams))
+	for i, t := range queryTrigrams {
+		placeholders[i] = "?"
+		trigramArgs[i] = t
+	}
+	inClause := strings.Join(placeholders, ",")
+
+	// Count, per candidate entity, how many of the query's trigrams it shares, and how
+	// many trigrams the candidate has in total (for the Jaccard denominator).
+	args := append([]any{string(entity)}, trigramArgs...)
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT st.entity_id,
+		       COUNT(*) AS shared,
+		       (SELECT COUNT(*) FROM search_trigram st2 WHERE st2.entity_type = st.entity_type AND st2.entity_id = st.entity_id) AS total
+		FROM search_trigram st
+		WHERE st.entity_type = ? AND st.trigram IN (%s)
+		GROUP BY st.entity_id
+	`, inClause), args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching trigrams for %s %q: %w", entity, q, err)
+	}
+	defer rows.Close()
+
+	var matches []trigramMatch
+	for rows.Next() {
+		var id string
+		var shared, total int
+		if err := rows.Scan(&id, &shared, &total); err != nil {
+			return nil, err
+		}
+		union := total + len(queryTrigrams) - shared
+		if union == 0 {
+			continue
+		}
+		score := float64(shared) / float64(union)
+		if score >= threshold {
+			matches = append(matches, trigramMatch{EntityType: string(entity), EntityID: id, Score: score})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches, nil
+}
+
+// indexTrigrams (re)populates search_trigram for a single entity row. It is called by
+// IndexEntity. The search_fts triggers do not touch search_trigram, so rows written through
+// them get trigrams only on the next IndexEntity for that row or on IndexAll.
+func indexTrigrams(ctx context.Context, db *sql.DB, entity model.SearchEntity, id, name string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM search_trigram WHERE entity_type = ? AND entity_id = ?`, string(entity), id); err != nil {
+		return err
+	}
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO search_trigram (entity_type, entity_id, trigram) VALUES (?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, t := range trigramsOf(name) {
+		if _, err := stmt.ExecContext(ctx, string(entity), id, t); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// removeTrigrams deletes every search_trigram row for an entity. It is called by
+// RemoveEntity.
+func removeTrigrams(ctx context.Context, db *sql.DB, entity model.SearchEntity, id string) error {
+	_, err := db.ExecContext(ctx, `DELETE FROM search_trigram WHERE entity_type = ? AND entity_id = ?`, string(entity), id)
+	return err
+}
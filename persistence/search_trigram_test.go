@@ -0,0 +1,46 @@
+package persistence
+
+import "testing"
+
+func TestTrigramsOf(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"whitespace only", "   ", nil},
+		{"short word", "ab", []string{"  a", " ab", "ab "}},
+		{"longer word", "cat", []string{"  c", " ca", "cat", "at "}},
+		{"mixed case", "Cat", []string{"  c", " ca", "cat", "at "}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trigramsOf(tt.s)
+			if len(got) != len(tt.want) {
+				t.Fatalf("trigramsOf(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("trigramsOf(%q)[%d] = %q, want %q", tt.s, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTrigramThreshold(t *testing.T) {
+	tests := []struct {
+		fuzziness int
+		want      float64
+	}{
+		{FuzzinessOff, 1},
+		{FuzzinessDefault, 0.3},
+		{FuzzinessPermissive, 0.15},
+	}
+	for _, tt := range tests {
+		if got := trigramThreshold(tt.fuzziness); got != tt.want {
+			t.Errorf("trigramThreshold(%d) = %v, want %v", tt.fuzziness, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package responses
+
+// SavedSearches is the payload for the Navidrome-specific getSavedSearches.view endpoint.
+type SavedSearches struct {
+	SavedSearch []SavedSearch `xml:"savedSearch" json:"savedSearch,omitempty"`
+}
+
+type SavedSearch struct {
+	Id        string `xml:"id,attr" json:"id"`
+	Name      string `xml:"name,attr" json:"name"`
+	Query     string `xml:"query,attr,omitempty" json:"query,omitempty"`
+	Pinned    bool   `xml:"pinned,attr" json:"pinned"`
+	CreatedAt string `xml:"created,attr" json:"created"`
+}
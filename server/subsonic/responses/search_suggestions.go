@@ -0,0 +1,15 @@
+package responses
+
+// SearchSuggestions is the payload for the Navidrome-specific getSearchSuggestions.view
+// endpoint. It is intentionally lighter than SearchResult2/3, carrying just enough for an
+// as-you-type UI to render a completion list and jump straight to the matching entity.
+type SearchSuggestions struct {
+	Suggestion []SearchSuggestion `xml:"suggestion" json:"suggestion,omitempty"`
+}
+
+type SearchSuggestion struct {
+	Term     string `xml:"term,attr" json:"term"`
+	Type     string `xml:"type,attr" json:"type"`
+	Id       string `xml:"id,attr" json:"id"`
+	HitCount int32  `xml:"hitCount,attr" json:"hitCount"`
+}
@@ -0,0 +1,46 @@
+package subsonic
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/navidrome/navidrome/log"
+	"github.com/navidrome/navidrome/server/subsonic/responses"
+)
+
+// h adapts a Subsonic handler into an http.HandlerFunc.
+func h(handlerFunc func(r *http.Request) (*responses.Subsonic, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := handlerFunc(r)
+		if err != nil {
+			log.Error(r.Context(), "Error handling request", "path", r.URL.Path, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		if err := xml.NewEncoder(w).Encode(resp); err != nil {
+			log.Error(r.Context(), "Error encoding response", "path", r.URL.Path, err)
+		}
+	}
+}
+
+// routes registers the search, suggestion and saved-search endpoints. Each view is exposed
+// both with and without its ".view" suffix, matching every Subsonic client's URL style.
+func (api *Router) routes() http.Handler {
+	r := chi.NewRouter()
+	endpoints := map[string]http.HandlerFunc{
+		"search2":              h(api.Search2),
+		"search3":              h(api.Search3),
+		"getSearchSuggestions": h(api.GetSearchSuggestions),
+		"createSavedSearch":    h(api.CreateSavedSearch),
+		"getSavedSearches":     h(api.GetSavedSearches),
+		"deleteSavedSearch":    h(api.DeleteSavedSearch),
+		"runSavedSearch":       h(api.RunSavedSearch),
+	}
+	for path, handler := range endpoints {
+		r.Get("/"+path, handler)
+		r.Get("/"+path+".view", handler)
+	}
+	return r
+}
@@ -0,0 +1,135 @@
+package subsonic
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/navidrome/navidrome/model"
+	"github.com/navidrome/navidrome/server/subsonic/responses"
+	"github.com/navidrome/navidrome/utils/req"
+	"github.com/navidrome/navidrome/utils/slice"
+)
+
+func toSavedSearchResponse(s model.SavedSearch) responses.SavedSearch {
+	return responses.SavedSearch{
+		Id:        s.ID,
+		Name:      s.Name,
+		Query:     s.Query,
+		Pinned:    s.Pinned,
+		CreatedAt: s.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// CreateSavedSearch implements createSavedSearch.view: persists a query (free-text, using
+// the extended search syntax, and/or a rule-based definition) so it can be pinned and
+// re-executed later via runSavedSearch.
+func (api *Router) CreateSavedSearch(r *http.Request) (*responses.Subsonic, error) {
+	ctx := r.Context()
+	p := req.Params(r)
+	name, err := p.String("name")
+	if err != nil {
+		return nil, err
+	}
+
+	var addedAfter *time.Time
+	if dateAdded := p.StringOr("dateAdded", ""); dateAdded != "" {
+		t, err := time.Parse(time.RFC3339, dateAdded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dateAdded %q: %w", dateAdded, err)
+		}
+		addedAfter = &t
+	}
+
+	search := &model.SavedSearch{
+		Name:   name,
+		Query:  p.StringOr("query", ""),
+		Pinned: p.BoolOr("pinned", false),
+		Rules: model.SavedSearchRules{
+			Genre:        p.StringOr("genre", ""),
+			YearFrom:     p.IntOr("yearFrom", 0),
+			YearTo:       p.IntOr("yearTo", 0),
+			MinRating:    p.IntOr("minRating", 0),
+			MinPlayCount: p.IntOr("minPlayCount", 0),
+			AddedAfter:   addedAfter,
+		},
+	}
+	if err := api.ds.SavedSearch(ctx).Put(search); err != nil {
+		return nil, err
+	}
+
+	response := newResponse()
+	response.SavedSearches = &responses.SavedSearches{SavedSearch: []responses.SavedSearch{toSavedSearchResponse(*search)}}
+	return response, nil
+}
+
+// GetSavedSearches implements getSavedSearches.view: lists the current user's saved
+// searches, pinned ones first.
+func (api *Router) GetSavedSearches(r *http.Request) (*responses.Subsonic, error) {
+	ctx := r.Context()
+	all, err := api.ds.SavedSearch(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	response := newResponse()
+	response.SavedSearches = &responses.SavedSearches{SavedSearch: slice.Map(all, toSavedSearchResponse)}
+	return response, nil
+}
+
+// DeleteSavedSearch implements deleteSavedSearch.view.
+func (api *Router) DeleteSavedSearch(r *http.Request) (*responses.Subsonic, error) {
+	ctx := r.Context()
+	p := req.Params(r)
+	id, err := p.String("id")
+	if err != nil {
+		return nil, err
+	}
+	if err := api.ds.SavedSearch(ctx).Delete(id); err != nil {
+		return nil, err
+	}
+	return newResponse(), nil
+}
+
+// RunSavedSearch implements runSavedSearch.view: re-executes a saved search through the
+// same searchAll pipeline Search2/Search3 use, so it benefits from musicFolderIds library
+// filtering. A rule-based saved search (no free-text query) is run as a filter predicate
+// instead of a text match.
+func (api *Router) RunSavedSearch(r *http.Request) (*responses.Subsonic, error) {
+	ctx := r.Context()
+	p := req.Params(r)
+	id, err := p.String("id")
+	if err != nil {
+		return nil, err
+	}
+	saved, err := api.ds.SavedSearch(ctx).Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := api.getSearchParams(r)
+	if err != nil {
+		return nil, err
+	}
+	sp.query = saved.Query
+
+	musicFolderIds, err := selectedMusicFolderIds(r, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var extraFilters []squirrel.Sqlizer
+	if saved.Query == "" {
+		extraFilters = append(extraFilters, saved.Rules.ToFilters(saved.UserID))
+	}
+	mfs, als, as := api.searchAll(ctx, sp, musicFolderIds, extraFilters...)
+
+	response := newResponse()
+	searchResult3 := &responses.SearchResult3{}
+	searchResult3.Artist = slice.MapWithArg(as, r, toArtistID3)
+	searchResult3.Album = slice.MapWithArg(als, ctx, buildAlbumID3)
+	searchResult3.Song = slice.MapWithArg(mfs, ctx, childFromMediaFile)
+	response.SearchResult3 = searchResult3
+	return response, nil
+}
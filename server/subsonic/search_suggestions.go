@@ -0,0 +1,111 @@
+package subsonic
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/navidrome/navidrome/log"
+	"github.com/navidrome/navidrome/model"
+	"github.com/navidrome/navidrome/model/request"
+	"github.com/navidrome/navidrome/server/subsonic/responses"
+	"github.com/navidrome/navidrome/utils/req"
+	"golang.org/x/sync/errgroup"
+)
+
+// suggestionCacheTTL bounds how long a (user, prefix) suggestion list is reused across
+// keystrokes. As-you-type callers fire one request per keystroke, so a short TTL absorbs
+// most of that traffic without serving stale completions for long.
+const suggestionCacheTTL = 30 * time.Second
+
+type suggestionCacheEntry struct {
+	suggestions []responses.SearchSuggestion
+	expiresAt   time.Time
+}
+
+// suggestionCache is a tiny debounce-friendly cache keyed by user+query+count, so that the
+// burst of requests a client sends while the user is still typing a prefix don't each pay
+// for a full fan-out lookup across entity types.
+type suggestionCache struct {
+	mu      sync.Mutex
+	entries map[string]suggestionCacheEntry
+}
+
+func newSuggestionCache() *suggestionCache {
+	return &suggestionCache{entries: map[string]suggestionCacheEntry{}}
+}
+
+func (c *suggestionCache) get(key string) ([]responses.SearchSuggestion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, false
+	}
+	return e.suggestions, true
+}
+
+func (c *suggestionCache) put(key string, suggestions []responses.SearchSuggestion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = suggestionCacheEntry{suggestions: suggestions, expiresAt: time.Now().Add(suggestionCacheTTL)}
+}
+
+// GetSearchSuggestions implements the Navidrome-specific getSearchSuggestions.view
+// endpoint: a lightweight, ranked completion list for as-you-type UIs, keyed by entity
+// type. It reuses searchParams for the query parsing that Search2/Search3 already do, reads
+// its own count parameter (default 10), and runs one lookup per entity type in parallel,
+// same as searchAll.
+func (api *Router) GetSearchSuggestions(r *http.Request) (*responses.Subsonic, error) {
+	ctx := r.Context()
+	p := req.Params(r)
+	sp, err := api.getSearchParams(r)
+	if err != nil {
+		return nil, err
+	}
+	user, _ := request.UserFrom(ctx)
+	count := p.IntOr("count", 10)
+
+	cacheKey := user.ID + "|" + sp.query + "|" + sp.searchType + "|" + strconv.Itoa(count)
+	if cached, ok := api.suggestionCache.get(cacheKey); ok {
+		response := newResponse()
+		response.SearchSuggestions = &responses.SearchSuggestions{Suggestion: cached}
+		return response, nil
+	}
+
+	entities := []model.SearchEntity{model.SearchEntityArtist, model.SearchEntityAlbum, model.SearchEntityMediaFile}
+	results := make([][]model.SearchSuggestion, len(entities))
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i, entity := range entities {
+		i, entity := i, entity
+		g.Go(func() error {
+			suggestions, err := api.suggestionProvider.Suggest(ctx, user.ID, sp.query, entity, count)
+			if err != nil {
+				log.Error(ctx, "Error getting search suggestions", "entity", entity, "query", sp.query, err)
+				return nil
+			}
+			results[i] = suggestions
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var out []responses.SearchSuggestion
+	for _, rs := range results {
+		for _, s := range rs {
+			out = append(out, responses.SearchSuggestion{
+				Term:     s.Term,
+				Type:     string(s.Type),
+				Id:       s.ID,
+				HitCount: int32(s.HitCount),
+			})
+		}
+	}
+	api.suggestionCache.put(cacheKey, out)
+
+	response := newResponse()
+	response.SearchSuggestions = &responses.SearchSuggestions{Suggestion: out}
+	return response, nil
+}
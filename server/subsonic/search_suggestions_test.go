@@ -0,0 +1,43 @@
+package subsonic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/navidrome/navidrome/server/subsonic/responses"
+)
+
+func TestSuggestionCache(t *testing.T) {
+	c := newSuggestionCache()
+	want := []responses.SearchSuggestion{{Term: "beatles", Type: "artist", Id: "1"}}
+
+	if _, ok := c.get("missing"); ok {
+		t.Fatal("expected miss on an empty cache")
+	}
+
+	c.put("user1|beatles|fts|10", want)
+	got, ok := c.get("user1|beatles|fts|10")
+	if !ok {
+		t.Fatal("expected a hit right after put")
+	}
+	if len(got) != 1 || got[0] != want[0] {
+		t.Errorf("get() = %v, want %v", got, want)
+	}
+
+	// A different count is a different cache entry: see the getSearchSuggestions cacheKey,
+	// which must include count so a count=5 request can't be served a count=20 list.
+	if _, ok := c.get("user1|beatles|fts|20"); ok {
+		t.Fatal("expected a miss for a different count")
+	}
+}
+
+func TestSuggestionCache_Expiry(t *testing.T) {
+	c := newSuggestionCache()
+	c.entries["k"] = suggestionCacheEntry{
+		suggestions: []responses.SearchSuggestion{{Term: "stale"}},
+		expiresAt:   time.Now().Add(-time.Second),
+	}
+	if _, ok := c.get("k"); ok {
+		t.Fatal("expected an expired entry to be treated as a miss")
+	}
+}
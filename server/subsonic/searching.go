@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,6 +22,8 @@ import (
 
 type searchParams struct {
 	query        string
+	searchType   string
+	fuzziness    int
 	artistCount  int
 	artistOffset int
 	albumCount   int
@@ -29,10 +32,28 @@ type searchParams struct {
 	songOffset   int
 }
 
+// validSearchTypes enumerates the query dialects accepted by the searchType parameter.
+// "simple" keeps the historical LIKE-based matching used by repository Search methods;
+// "fts" opts into the model.SearchEngine-backed query syntax (quoted phrases, AND/OR/NOT,
+// field prefixes like artist:/album:/genre:/year:1975..1980, BM25 ranking). Defaulting to
+// "simple" keeps the existing Subsonic contract unchanged for clients that don't send it.
+var validSearchTypes = map[string]bool{"simple": true, "fts": true}
+
 func (api *Router) getSearchParams(r *http.Request) (*searchParams, error) {
 	p := req.Params(r)
 	sp := &searchParams{}
 	sp.query = p.StringOr("query", `""`)
+	sp.searchType = strings.ToLower(p.StringOr("searchType", "simple"))
+	if !validSearchTypes[sp.searchType] {
+		return nil, fmt.Errorf("invalid searchType %q", sp.searchType)
+	}
+	// fuzziness opts into trigram-based typo-tolerant matching: 0 (default) keeps exact/prefix
+	// matching only, preserving existing client behavior; 1 is the default trigram overlap
+	// threshold (~0.3 Jaccard), 2 is the permissive threshold (~0.15).
+	sp.fuzziness = p.IntOr("fuzziness", 0)
+	if sp.fuzziness < 0 || sp.fuzziness > 2 {
+		return nil, fmt.Errorf("invalid fuzziness %d, must be 0, 1 or 2", sp.fuzziness)
+	}
 	sp.artistCount = p.IntOr("artistCount", 20)
 	sp.artistOffset = p.IntOr("artistOffset", 0)
 	sp.albumCount = p.IntOr("albumCount", 20)
@@ -62,18 +83,21 @@ func callSearch[T any](ctx context.Context, s searchFunc[T], q string, offset, s
 	}
 }
 
-func (api *Router) searchAll(ctx context.Context, sp *searchParams, musicFolderIds []int) (mediaFiles model.MediaFiles, albums model.Albums, artists model.Artists) {
+func (api *Router) searchAll(ctx context.Context, sp *searchParams, musicFolderIds []int, extraFilters ...Sqlizer) (mediaFiles model.MediaFiles, albums model.Albums, artists model.Artists) {
 	start := time.Now()
 	q := sanitize.Accents(strings.ToLower(strings.TrimSuffix(sp.query, "*")))
 
 	// Create query options for library filtering
 	var options []model.QueryOptions
 	var artistOptions []model.QueryOptions
+	// mediaFilters combines the library_id restriction with extraFilters, which carries
+	// rule-based saved search predicates (see runSavedSearch). extraFilters only applies to
+	// MediaFiles/Albums, not Artists, since rules are defined in terms of track/album
+	// attributes (genre, year, rating, play count, dateAdded).
+	mediaFilters := And{}
 	if len(musicFolderIds) > 0 {
 		// For MediaFiles and Albums, use direct library_id filter
-		options = append(options, model.QueryOptions{
-			Filters: Eq{"library_id": musicFolderIds},
-		})
+		mediaFilters = append(mediaFilters, Eq{"library_id": musicFolderIds})
 		// For Artists, use the repository's built-in library filtering mechanism
 		// which properly handles the library_artist table joins
 		// TODO Revisit library filtering in sql_base_repository.go
@@ -81,22 +105,113 @@ func (api *Router) searchAll(ctx context.Context, sp *searchParams, musicFolderI
 			Filters: Eq{"library_artist.library_id": musicFolderIds},
 		})
 	}
+	mediaFilters = append(mediaFilters, extraFilters...)
+	if len(mediaFilters) > 0 {
+		options = append(options, model.QueryOptions{Filters: mediaFilters})
+	}
 
-	// Run searches in parallel
-	g, ctx := errgroup.WithContext(ctx)
-	g.Go(callSearch(ctx, api.ds.MediaFile(ctx).Search, q, sp.songOffset, sp.songCount, &mediaFiles, options...))
-	g.Go(callSearch(ctx, api.ds.Album(ctx).Search, q, sp.albumOffset, sp.albumCount, &albums, options...))
-	g.Go(callSearch(ctx, api.ds.Artist(ctx).Search, q, sp.artistOffset, sp.artistCount, &artists, artistOptions...))
-	err := g.Wait()
-	if err == nil {
-		log.Debug(ctx, fmt.Sprintf("Search resulted in %d songs, %d albums and %d artists",
-			len(mediaFiles), len(albums), len(artists)), "query", sp.query, "elapsedTime", time.Since(start))
+	// searchType=fts opts into the model.SearchEngine-backed query syntax; fuzziness>0 also
+	// goes through the SearchEngine, which does the trigram matching.
+	if sp.searchType == "fts" || sp.fuzziness > 0 {
+		mediaFiles, albums, artists = api.searchAllFTS(ctx, sp, options, artistOptions)
 	} else {
-		log.Warn(ctx, "Search was interrupted", "query", sp.query, "elapsedTime", time.Since(start), err)
+		// Run searches in parallel
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(callSearch(gctx, api.ds.MediaFile(gctx).Search, q, sp.songOffset, sp.songCount, &mediaFiles, options...))
+		g.Go(callSearch(gctx, api.ds.Album(gctx).Search, q, sp.albumOffset, sp.albumCount, &albums, options...))
+		g.Go(callSearch(gctx, api.ds.Artist(gctx).Search, q, sp.artistOffset, sp.artistCount, &artists, artistOptions...))
+		if err := g.Wait(); err != nil {
+			log.Warn(ctx, "Search was interrupted", "query", sp.query, "elapsedTime", time.Since(start), err)
+		}
 	}
+
+	log.Debug(ctx, fmt.Sprintf("Search resulted in %d songs, %d albums and %d artists",
+		len(mediaFiles), len(albums), len(artists)), "query", sp.query, "searchType", sp.searchType, "elapsedTime", time.Since(start))
 	return mediaFiles, albums, artists
 }
 
+// searchAllFTS runs the searchType=fts (and/or fuzziness>0) path: it asks the
+// model.SearchEngine for ranked hit IDs per entity type (phrases, boolean operators, field
+// prefixes and trigram fallback all handled there), then hydrates the matching rows through
+// the ordinary repositories so the result shape is identical to the "simple" path, just
+// reordered by relevance instead of by offset/size.
+func (api *Router) searchAllFTS(ctx context.Context, sp *searchParams, mediaOptions, artistOptions []model.QueryOptions) (mediaFiles model.MediaFiles, albums model.Albums, artists model.Artists) {
+	engine := api.ds.SearchEngine()
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		ids, err := searchEngineHitIDs(ctx, engine, model.SearchEntityMediaFile, sp.query, sp.fuzziness, sp.songOffset, sp.songCount)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		all, err := api.ds.MediaFile(ctx).GetAll(append(append([]model.QueryOptions{}, mediaOptions...), model.QueryOptions{Filters: Eq{"id": ids}})...)
+		if err != nil {
+			return err
+		}
+		mediaFiles = reorderByIDs(all, ids, func(m model.MediaFile) string { return m.ID })
+		return nil
+	})
+	g.Go(func() error {
+		ids, err := searchEngineHitIDs(ctx, engine, model.SearchEntityAlbum, sp.query, sp.fuzziness, sp.albumOffset, sp.albumCount)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		all, err := api.ds.Album(ctx).GetAll(append(append([]model.QueryOptions{}, mediaOptions...), model.QueryOptions{Filters: Eq{"id": ids}})...)
+		if err != nil {
+			return err
+		}
+		albums = reorderByIDs(all, ids, func(a model.Album) string { return a.ID })
+		return nil
+	})
+	g.Go(func() error {
+		ids, err := searchEngineHitIDs(ctx, engine, model.SearchEntityArtist, sp.query, sp.fuzziness, sp.artistOffset, sp.artistCount)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		all, err := api.ds.Artist(ctx).GetAll(append(append([]model.QueryOptions{}, artistOptions...), model.QueryOptions{Filters: Eq{"id": ids}})...)
+		if err != nil {
+			return err
+		}
+		artists = reorderByIDs(all, ids, func(a model.Artist) string { return a.ID })
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		log.Error(ctx, "Error running FTS search", "query", sp.query, err)
+	}
+	return mediaFiles, albums, artists
+}
+
+func searchEngineHitIDs(ctx context.Context, engine model.SearchEngine, entity model.SearchEntity, q string, fuzziness, offset, size int) ([]string, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	hits, err := engine.Search(ctx, entity, q, fuzziness, offset, size)
+	if err != nil {
+		return nil, fmt.Errorf("searching %s via FTS engine: %w", entity, err)
+	}
+	ids := make([]string, len(hits))
+	for i, h := range hits {
+		ids[i] = h.ID
+	}
+	return ids, nil
+}
+
+// reorderByIDs reorders items (fetched in arbitrary DB order via an "id IN (...)" filter)
+// to match the rank order of ids, so relevance ranking from the SearchEngine survives the
+// hydration round-trip through the ordinary repositories.
+func reorderByIDs[T any](items []T, ids []string, idOf func(T) string) []T {
+	rank := make(map[string]int, len(ids))
+	for i, id := range ids {
+		rank[id] = i
+	}
+	out := make([]T, len(items))
+	copy(out, items)
+	sort.SliceStable(out, func(i, j int) bool {
+		return rank[idOf(out[i])] < rank[idOf(out[j])]
+	})
+	return out
+}
+
 func (api *Router) Search2(r *http.Request) (*responses.Subsonic, error) {
 	ctx := r.Context()
 	sp, err := api.getSearchParams(r)
@@ -0,0 +1,19 @@
+package subsonic
+
+import "github.com/navidrome/navidrome/model"
+
+// Router aggregates the Subsonic API handlers and the dependencies they share.
+type Router struct {
+	ds                 model.DataStore
+	suggestionProvider model.SuggestionProvider
+	suggestionCache    *suggestionCache
+}
+
+// New creates a Router wired to ds, ready to serve Subsonic API requests.
+func New(ds model.DataStore) *Router {
+	return &Router{
+		ds:                 ds,
+		suggestionProvider: ds.SuggestionProvider(),
+		suggestionCache:    newSuggestionCache(),
+	}
+}